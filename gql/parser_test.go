@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"os"
 	"runtime/debug"
+	"strings"
 	"testing"
 
 	"github.com/dgraph-io/dgo/v200/protos/api"
@@ -2751,6 +2752,21 @@ func TestParseComments1(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestParseCommentsInString(t *testing.T) {
+	query := `
+	# Something
+	{ # Something
+		me(func: eq(name, "foo # bar")) { # Something
+			name # Something
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "foo # bar", res.Query[0].Func.Args[0].Value)
+	require.Equal(t, []string{"name"}, childAttrs(res.Query[0]))
+}
+
 func TestParseGenerator(t *testing.T) {
 	query := `{
 		me(func:allofterms(name, "barack")) {
@@ -4756,6 +4772,33 @@ func TestParseMissingGraphQLVar(t *testing.T) {
 	}
 }
 
+func TestParseGraphQLVarInjection(t *testing.T) {
+	query := `query test($a: string) { q(func: eq(name, $a)) { name }}`
+	for _, val := range []string{
+		`") { uid } x(func: has(password`,
+		`alice" } }`,
+		`{ q(func: has(password)) { password } }`,
+		`\"\n\\ # not a comment`,
+		`") { uid }`,
+		`\u0022) { uid } x(func: has(password`,
+		strings.Repeat(`"}{`, 1000),
+	} {
+		r := Request{
+			Str:       query,
+			Variables: map[string]string{"$a": val},
+		}
+		res, err := Parse(r)
+		require.NoError(t, err)
+		require.Len(t, res.Query, 1)
+		require.Equal(t, "q", res.Query[0].Alias)
+		require.Equal(t, "eq", res.Query[0].Func.Name)
+		require.Len(t, res.Query[0].Func.Args, 1)
+		require.Equal(t, val, res.Query[0].Func.Args[0].Value)
+		require.Len(t, res.Query[0].Children, 1)
+		require.Equal(t, "name", res.Query[0].Children[0].Attr)
+	}
+}
+
 func TestParseGraphQLVarPaginationRoot(t *testing.T) {
 	for _, q := range []string{
 		"query test($a: int = 2){ q(func: uid(0x1), first: $a) { name }}",